@@ -0,0 +1,125 @@
+package wordgen
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordGenBatchBorrow(t *testing.T) {
+	pg, err := NewWordGen(Config{
+		Charset:    "abc",
+		MinLen:     3,
+		MaxLen:     3,
+		BufferSize: 8,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh, err := pg.Run(ctx)
+	require.NoError(t, err)
+	defer func() {
+		pg.Stop()
+		require.NoError(t, <-errCh)
+	}()
+
+	// BatchBorrow returns up to n words: it may return fewer if the
+	// producer hasn't caught up yet, so drain in a loop until the total
+	// requested has been seen.
+	var total int
+	for total < 4 {
+		words, release, err := pg.BatchBorrow(4 - total)
+		require.NoError(t, err)
+		require.NotEmpty(t, words)
+
+		for _, w := range words {
+			require.Len(t, w, 3)
+		}
+
+		total += len(words)
+		release()
+	}
+
+	words, release, err := pg.BatchBorrow(1)
+	require.NoError(t, err)
+	require.Len(t, words, 1)
+
+	// a second borrow before releasing the first must be rejected
+	_, _, err = pg.BatchBorrow(1)
+	require.Error(t, err)
+
+	release()
+	release() // idempotent
+
+	more, release2, err := pg.BatchBorrow(2)
+	require.NoError(t, err)
+	require.Len(t, more, 2)
+	release2()
+}
+
+func TestWordGenNextAndBatchRejectOutstandingBorrow(t *testing.T) {
+	pg, err := NewWordGen(Config{
+		Charset:    "abc",
+		MinLen:     3,
+		MaxLen:     3,
+		BufferSize: 8,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh, err := pg.Run(ctx)
+	require.NoError(t, err)
+	defer func() {
+		pg.Stop()
+		require.NoError(t, <-errCh)
+	}()
+
+	words, release, err := pg.BatchBorrow(1)
+	require.NoError(t, err)
+	require.Len(t, words, 1)
+	borrowed := string(words[0])
+
+	_, err = pg.Next()
+	require.Error(t, err)
+
+	_, err = pg.Batch(make([][]byte, 1))
+	require.Error(t, err)
+
+	release()
+
+	word, err := pg.Next()
+	require.NoError(t, err)
+	require.NotEqual(t, borrowed, string(word))
+}
+
+func TestWordGenBatchBorrowEOF(t *testing.T) {
+	pg, err := NewWordGen(Config{
+		Charset:    "a",
+		MinLen:     1,
+		MaxLen:     1,
+		BufferSize: 4,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh, err := pg.Run(ctx)
+	require.NoError(t, err)
+
+	words, release, err := pg.BatchBorrow(1)
+	require.NoError(t, err)
+	require.Len(t, words, 1)
+	release()
+
+	_, _, err = pg.BatchBorrow(1)
+	require.ErrorIs(t, err, io.EOF)
+
+	require.NoError(t, <-errCh)
+}
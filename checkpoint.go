@@ -0,0 +1,158 @@
+package wordgen
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// checkpointVersion is bumped whenever the binary layout produced by
+// Checkpoint changes, so that NewWordGenFromCheckpoint can reject
+// checkpoints it no longer knows how to read.
+const checkpointVersion byte = 1
+
+const checkpointFingerprintLen = sha256.Size
+
+// Checkpoint serializes the generator's current enumeration position
+// (state, stateLen and the generated count) into a versioned binary blob
+// that NewWordGenFromCheckpoint can later resume from. It is only
+// meaningful in ModeSequential; ModeRandom and ModePronounceable have no
+// enumeration position to capture, and Checkpoint returns a blob that
+// simply carries the generated count forward.
+//
+// Checkpoint is not supported when Config.Workers > 1 shards a
+// ModeSequential run, since a sharded run has no single enumeration
+// position to capture; it returns nil, which NewWordGenFromCheckpoint then
+// rejects as too short to be valid.
+func (pg *WordGen) Checkpoint() []byte {
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+
+	if pg.config.Workers > 1 && pg.config.Mode == ModeSequential {
+		return nil
+	}
+
+	state, stateLen := pg.state, pg.stateLen
+	if pg.startTime.IsZero() {
+		// Run hasn't started yet, so the position is where it will begin
+		state, stateLen = pg.initialState, len(pg.initialState)
+	}
+
+	fingerprint := configFingerprint(effectiveCharset(pg.config), pg.config.MinLen, pg.config.MaxLen)
+
+	buf := make([]byte, 0, 1+checkpointFingerprintLen+8+8+8+len(state)*8)
+	buf = append(buf, checkpointVersion)
+	buf = append(buf, fingerprint[:]...)
+	buf = binary.BigEndian.AppendUint64(buf, pg.generated)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(stateLen))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(len(state)))
+
+	for _, s := range state {
+		buf = binary.BigEndian.AppendUint64(buf, uint64(s))
+	}
+
+	return buf
+}
+
+// NewWordGenFromCheckpoint creates a WordGen that resumes enumeration from
+// a checkpoint previously produced by Checkpoint. config must describe the
+// same Charset, Exclude, MinLen and MaxLen the checkpoint was taken with;
+// otherwise NewWordGenFromCheckpoint returns a clear error instead of
+// silently resuming from the wrong position in a different keyspace.
+func NewWordGenFromCheckpoint(config Config, ckpt []byte) (*WordGen, error) {
+	state, generated, err := decodeCheckpoint(config, ckpt)
+	if err != nil {
+		return nil, fmt.Errorf("decode checkpoint: %w", err)
+	}
+
+	config.InitialState = nil
+
+	pg, err := NewWordGen(config)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, s := range state {
+		if s >= pg.charsetLen {
+			return nil, fmt.Errorf("checkpoint state byte %d (%d) is out of range for a %d-character charset", i, s, pg.charsetLen)
+		}
+	}
+
+	pg.initialState = state
+	pg.generated = generated
+
+	return pg, nil
+}
+
+// decodeCheckpoint validates ckpt against config and returns the decoded
+// state and generated count.
+func decodeCheckpoint(config Config, ckpt []byte) (state []uint, generated uint64, err error) {
+	const headerLen = 1 + checkpointFingerprintLen + 8 + 8 + 8
+
+	if len(ckpt) < headerLen {
+		return nil, 0, fmt.Errorf("checkpoint is too short to be valid (got %d bytes, need at least %d)", len(ckpt), headerLen)
+	}
+
+	if ckpt[0] != checkpointVersion {
+		return nil, 0, fmt.Errorf("unsupported checkpoint version %d (expected %d)", ckpt[0], checkpointVersion)
+	}
+
+	offset := 1
+
+	var fingerprint [checkpointFingerprintLen]byte
+	copy(fingerprint[:], ckpt[offset:offset+checkpointFingerprintLen])
+	offset += checkpointFingerprintLen
+
+	normalized, err := normalizeConfig(config)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	want := configFingerprint(effectiveCharset(normalized), normalized.MinLen, normalized.MaxLen)
+	if fingerprint != want {
+		return nil, 0, fmt.Errorf("checkpoint was taken with a different charset/length configuration")
+	}
+
+	generated = binary.BigEndian.Uint64(ckpt[offset : offset+8])
+	offset += 8
+
+	stateLen := int(binary.BigEndian.Uint64(ckpt[offset : offset+8]))
+	offset += 8
+
+	stateCount := int(binary.BigEndian.Uint64(ckpt[offset : offset+8]))
+	offset += 8
+
+	if stateCount != stateLen {
+		return nil, 0, fmt.Errorf("checkpoint is corrupt: stateLen (%d) does not match encoded state size (%d)", stateLen, stateCount)
+	}
+
+	if len(ckpt) != offset+stateCount*8 {
+		return nil, 0, fmt.Errorf("checkpoint length does not match its encoded state size")
+	}
+
+	state = make([]uint, stateCount)
+	for i := 0; i < stateCount; i++ {
+		state[i] = uint(binary.BigEndian.Uint64(ckpt[offset : offset+8]))
+		offset += 8
+	}
+
+	return state, generated, nil
+}
+
+// configFingerprint hashes the parts of a configuration that determine the
+// shape of the keyspace being enumerated, so that a checkpoint can only be
+// resumed against a compatible configuration.
+func configFingerprint(charset []byte, minLen, maxLen uint) [checkpointFingerprintLen]byte {
+	h := sha256.New()
+	h.Write(charset)
+
+	var lenBuf [16]byte
+	binary.BigEndian.PutUint64(lenBuf[0:8], uint64(minLen))
+	binary.BigEndian.PutUint64(lenBuf[8:16], uint64(maxLen))
+	h.Write(lenBuf[:])
+
+	var sum [checkpointFingerprintLen]byte
+	copy(sum[:], h.Sum(nil))
+
+	return sum
+}
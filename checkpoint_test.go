@@ -0,0 +1,98 @@
+package wordgen
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// drainAll runs a fresh generator with cfg to completion and returns every
+// word it produced, in order.
+func drainAll(t *testing.T, cfg Config) []string {
+	t.Helper()
+
+	pg, err := NewWordGen(cfg)
+	require.NoError(t, err)
+
+	errCh, err := pg.Run(context.Background())
+	require.NoError(t, err)
+
+	var words []string
+	for {
+		word, err := pg.Next()
+		if err != nil {
+			require.ErrorIs(t, err, io.EOF)
+			break
+		}
+		words = append(words, string(word))
+	}
+
+	require.NoError(t, <-errCh)
+
+	return words
+}
+
+func TestWordGenCheckpointResume(t *testing.T) {
+	cfg := Config{
+		Charset:    "abc",
+		MinLen:     1,
+		MaxLen:     3,
+		BufferSize: 1,
+	}
+
+	full := drainAll(t, cfg)
+
+	pg, err := NewWordGen(cfg)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh, err := pg.Run(ctx)
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err := pg.Next()
+		require.NoError(t, err)
+	}
+
+	pg.Stop()
+	require.NoError(t, <-errCh)
+
+	generatedAtCheckpoint, _ := pg.Stats()
+	ckpt := pg.Checkpoint()
+
+	resumed, err := NewWordGenFromCheckpoint(cfg, ckpt)
+	require.NoError(t, err)
+
+	errCh2, err := resumed.Run(context.Background())
+	require.NoError(t, err)
+
+	var rest []string
+	for {
+		word, err := resumed.Next()
+		if err != nil {
+			require.ErrorIs(t, err, io.EOF)
+			break
+		}
+		rest = append(rest, string(word))
+	}
+	require.NoError(t, <-errCh2)
+
+	require.Equal(t, full[generatedAtCheckpoint:], rest)
+}
+
+func TestWordGenCheckpointMismatch(t *testing.T) {
+	pg, err := NewWordGen(Config{Charset: "abc", MinLen: 1, MaxLen: 3, BufferSize: 4})
+	require.NoError(t, err)
+
+	ckpt := pg.Checkpoint()
+
+	_, err = NewWordGenFromCheckpoint(Config{Charset: "xyz", MinLen: 1, MaxLen: 3, BufferSize: 4}, ckpt)
+	require.Error(t, err)
+
+	_, err = NewWordGenFromCheckpoint(Config{Charset: "abc", MinLen: 1, MaxLen: 3, BufferSize: 4}, []byte("short"))
+	require.Error(t, err)
+}
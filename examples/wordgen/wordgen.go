@@ -13,6 +13,8 @@ import (
 	"github.com/xiam/wordgen"
 )
 
+const checkpointInterval = 30 * time.Second
+
 const (
 	bufferSize = uint64(8e6)
 	batchSize  = uint64(1e3)
@@ -26,6 +28,8 @@ func main() {
 
 	printPasswords := flag.Bool("print", false, "Print each generated password (warning: high output volume)")
 
+	checkpointFile := flag.String("checkpoint-file", "", "File to periodically save generation progress to and resume from on startup")
+
 	// Parse command-line flags
 	flag.Parse()
 
@@ -47,30 +51,17 @@ func main() {
 		BufferSize: bufferSize,
 	}
 
-	fmt.Printf("Generating passwords with:\n")
-	fmt.Printf("- Character set: %s (%d characters)\n", *charset, len(*charset))
-	fmt.Printf("- Length range: %d to %d characters\n", *minLen, *maxLen)
-
-	// Calculate the theoretical total
-	charsetLen := len(*charset)
-	theoretical := big.NewInt(0)
-	for length := *minLen; length <= *maxLen; length++ {
-		// Calculate charset^length
-		lengthCombinations := big.NewInt(1)
-		for i := uint(0); i < length; i++ {
-			lengthCombinations.Mul(lengthCombinations, big.NewInt(int64(charsetLen)))
-		}
-		theoretical.Add(theoretical, lengthCombinations)
-	}
-
-	fmt.Printf("- Theoretical total: %s passwords\n\n", formatBigNumber(theoretical))
-
-	generator, err := wordgen.NewWordGen(config)
+	generator, err := newGeneratorWithCheckpoint(config, *checkpointFile)
 	if err != nil {
 		fmt.Printf("Error creating generator: %v\n", err)
 		os.Exit(1)
 	}
 
+	fmt.Printf("Generating passwords with:\n")
+	fmt.Printf("- Character set: %s (%d characters)\n", *charset, len(*charset))
+	fmt.Printf("- Length range: %d to %d characters\n", *minLen, *maxLen)
+	fmt.Printf("- Theoretical total: %s passwords (%.1f bits of entropy)\n\n", formatBigNumber(generator.Keyspace()), generator.Entropy())
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -89,6 +80,13 @@ func main() {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
 
+	// Create a ticker to periodically save a checkpoint, if requested
+	var checkpointTicker *time.Ticker
+	if *checkpointFile != "" {
+		checkpointTicker = time.NewTicker(checkpointInterval)
+		defer checkpointTicker.Stop()
+	}
+
 	// Process passwords until we're done
 loop:
 	for {
@@ -113,6 +111,11 @@ loop:
 				fmt.Printf("Progress: %d passwords, %.2f passwords/sec\n", count, rate)
 			}
 
+		case <-checkpointTickerC(checkpointTicker):
+			if err := saveCheckpoint(generator, *checkpointFile); err != nil {
+				fmt.Printf("Error saving checkpoint: %v\n", err)
+			}
+
 		default:
 		}
 
@@ -134,6 +137,12 @@ loop:
 		}
 	}
 
+	if *checkpointFile != "" {
+		if err := saveCheckpoint(generator, *checkpointFile); err != nil {
+			fmt.Printf("Error saving checkpoint: %v\n", err)
+		}
+	}
+
 	// Get final stats
 	count, duration := generator.Stats()
 	rate := float64(count) / duration.Seconds()
@@ -144,6 +153,42 @@ loop:
 	fmt.Printf("Generation speed: %s passwords/sec\n", formatNumber(uint64(rate)))
 }
 
+// newGeneratorWithCheckpoint creates a generator from config, resuming from
+// checkpointFile if it already exists, or starting fresh otherwise.
+func newGeneratorWithCheckpoint(config wordgen.Config, checkpointFile string) (*wordgen.WordGen, error) {
+	if checkpointFile == "" {
+		return wordgen.NewWordGen(config)
+	}
+
+	data, err := os.ReadFile(checkpointFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return wordgen.NewWordGen(config)
+		}
+
+		return nil, fmt.Errorf("read checkpoint file: %w", err)
+	}
+
+	fmt.Printf("Resuming from checkpoint: %s\n", checkpointFile)
+
+	return wordgen.NewWordGenFromCheckpoint(config, data)
+}
+
+// saveCheckpoint writes generator's current progress to checkpointFile.
+func saveCheckpoint(generator *wordgen.WordGen, checkpointFile string) error {
+	return os.WriteFile(checkpointFile, generator.Checkpoint(), 0o600)
+}
+
+// checkpointTickerC returns t.C, or a nil channel (which blocks forever in a
+// select) when t is nil, i.e. when checkpointing wasn't requested.
+func checkpointTickerC(t *time.Ticker) <-chan time.Time {
+	if t == nil {
+		return nil
+	}
+
+	return t.C
+}
+
 // formatNumber formats a uint64 with thousand separators
 func formatNumber(n uint64) string {
 	if n < 1000 {
@@ -0,0 +1,117 @@
+package wordgen
+
+import (
+	"math"
+	"math/big"
+	"time"
+)
+
+// Keyspace returns the total number of distinct words this generator's
+// charset and length range can produce: the sum of charsetLen^length for
+// every length in [MinLen, MaxLen]. It does not account for
+// Config.Require/Config.RequireCustom, which only filter the keyspace as
+// it is walked rather than shrink it.
+func (pg *WordGen) Keyspace() *big.Int {
+	return keyspaceSum(pg.charsetLen, pg.config.MinLen, pg.config.MaxLen)
+}
+
+// Entropy returns the size of the keyspace in bits, i.e. log2(Keyspace()).
+func (pg *WordGen) Entropy() float64 {
+	f := new(big.Float).SetInt(pg.Keyspace())
+
+	// big.Float.MantExp avoids overflowing a float64's exponent range for
+	// keyspaces many orders of magnitude larger than float64 can represent
+	// directly.
+	var mantissa big.Float
+	exp := f.MantExp(&mantissa)
+	m, _ := mantissa.Float64()
+
+	return math.Log2(m) + float64(exp)
+}
+
+// Progress reports how far a ModeSequential enumeration has advanced: done
+// is the number of words fully walked so far, derived from the current
+// state/stateLen rather than the post-filter generated count, total is
+// Keyspace(), and eta estimates the remaining time from the observed
+// generation rate. done is always 0 in ModeRandom and ModePronounceable,
+// which have no enumeration position to report, and when Config.Workers > 1,
+// where each worker has its own independent position.
+func (pg *WordGen) Progress() (done *big.Int, total *big.Int, eta time.Duration) {
+	total = pg.Keyspace()
+
+	pg.mu.Lock()
+	mode := pg.config.Mode
+	sharded := pg.workers != nil
+	state := append([]uint(nil), pg.state...)
+	stateLen := pg.stateLen
+	generated := pg.generated
+	startTime := pg.startTime
+	endTime := pg.endTime
+	pg.mu.Unlock()
+
+	if mode != ModeSequential || sharded {
+		return big.NewInt(0), total, 0
+	}
+
+	if startTime.IsZero() {
+		return big.NewInt(0), total, 0
+	}
+
+	done = keyspaceSum(pg.charsetLen, pg.config.MinLen, uint(stateLen)-1)
+	done.Add(done, stateValue(pg.charsetLen, state))
+
+	elapsed := endTime.Sub(startTime)
+	if endTime.IsZero() {
+		elapsed = time.Since(startTime)
+	}
+
+	rate := float64(generated) / elapsed.Seconds()
+	if rate <= 0 {
+		return done, total, 0
+	}
+
+	remaining := new(big.Int).Sub(total, done)
+	remainingFloat := bigIntToFloat64(remaining)
+
+	return done, total, time.Duration(remainingFloat/rate) * time.Second
+}
+
+// keyspaceSum returns the sum of charsetLen^length for length in
+// [minLen, maxLen]. If maxLen < minLen, e.g. because no length is yet
+// exhausted, it returns 0.
+func keyspaceSum(charsetLen, minLen, maxLen uint) *big.Int {
+	total := big.NewInt(0)
+
+	if maxLen < minLen {
+		return total
+	}
+
+	base := big.NewInt(int64(charsetLen))
+
+	for length := minLen; length <= maxLen; length++ {
+		total.Add(total, new(big.Int).Exp(base, big.NewInt(int64(length)), nil))
+	}
+
+	return total
+}
+
+// stateValue interprets state as a base-charsetLen number, with state[0]
+// the least significant digit - the same order nextState increments in, so
+// this is exactly the count of same-length words enumerated before state.
+func stateValue(charsetLen uint, state []uint) *big.Int {
+	value := big.NewInt(0)
+	place := big.NewInt(1)
+	base := big.NewInt(int64(charsetLen))
+
+	for _, s := range state {
+		value.Add(value, new(big.Int).Mul(place, big.NewInt(int64(s))))
+		place.Mul(place, base)
+	}
+
+	return value
+}
+
+func bigIntToFloat64(n *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(n).Float64()
+	return f
+}
@@ -0,0 +1,51 @@
+package wordgen
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyspace(t *testing.T) {
+	pg, err := NewWordGen(Config{Charset: "abcd", MinLen: 1, MaxLen: 3, BufferSize: 64})
+	require.NoError(t, err)
+
+	// 4 + 16 + 64
+	require.Equal(t, big.NewInt(84), pg.Keyspace())
+}
+
+func TestEntropy(t *testing.T) {
+	pg, err := NewWordGen(Config{Charset: "abcd", MinLen: 4, MaxLen: 4, BufferSize: 64})
+	require.NoError(t, err)
+
+	// 4^4 = 256 = 2^8
+	require.InDelta(t, 8.0, pg.Entropy(), 1e-9)
+}
+
+func TestProgressSequential(t *testing.T) {
+	words := drainAll(t, Config{Charset: "ab", MinLen: 2, MaxLen: 2, BufferSize: 64})
+	require.Len(t, words, 4)
+
+	pg, err := NewWordGen(Config{Charset: "ab", MinLen: 2, MaxLen: 2, BufferSize: 64})
+	require.NoError(t, err)
+
+	done, total, _ := pg.Progress()
+	require.Equal(t, big.NewInt(0), done)
+	require.Equal(t, big.NewInt(4), total)
+}
+
+func TestProgressIgnoredForRandomAndWorkers(t *testing.T) {
+	pg, err := NewWordGen(Config{Charset: "ab", MinLen: 2, MaxLen: 2, BufferSize: 64, Mode: ModeRandom, Count: 5})
+	require.NoError(t, err)
+
+	done, _, eta := pg.Progress()
+	require.Equal(t, big.NewInt(0), done)
+	require.Zero(t, eta)
+
+	pg, err = NewWordGen(Config{Charset: "abcd", MinLen: 1, MaxLen: 3, BufferSize: 64, Workers: 2})
+	require.NoError(t, err)
+
+	done, _, _ = pg.Progress()
+	require.Equal(t, big.NewInt(0), done)
+}
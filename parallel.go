@@ -0,0 +1,279 @@
+package wordgen
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// workerRing is one worker's private output ring when Config.Workers > 1. It
+// mirrors the producer/consumer design of WordGen's own ring buffer
+// (mutex-guarded, power-of-two sized, scratch-pool backed) rather than a
+// true lock-free SPSC queue: exactly one goroutine ever writes to it and the
+// only reader is the round-robin drain in nextFromWorkers, so contention on
+// its mutex is negligible.
+type workerRing struct {
+	shard *shard
+
+	mu      sync.Mutex
+	bufMask uint64
+	buf     [][]byte
+	r, w    uint64
+
+	running bool
+
+	generated uint64
+	scanned   uint64
+
+	scratchPool sync.Pool
+}
+
+func newWorkerRing(sh *shard, bufSize uint64, maxLen uint) *workerRing {
+	size := roundToNearestPowerOfTwo(bufSize)
+
+	r := &workerRing{
+		shard:   sh,
+		bufMask: size - 1,
+		buf:     make([][]byte, size),
+		running: true,
+	}
+
+	for i := range r.buf {
+		r.buf[i] = make([]byte, 0, maxLen)
+	}
+
+	r.scratchPool.New = func() interface{} {
+		return make([]byte, 0, maxLen)
+	}
+
+	return r
+}
+
+func (r *workerRing) getScratch() []byte {
+	return r.scratchPool.Get().([]byte)[:0]
+}
+
+func (r *workerRing) putScratch(b []byte) {
+	if b == nil {
+		return
+	}
+
+	r.scratchPool.Put(b[:0])
+}
+
+// tryTake returns the next word in the ring without blocking, if one is
+// available. done reports whether the worker has stopped and its ring is
+// fully drained, meaning it will never produce another word.
+func (r *workerRing) tryTake() (word []byte, ok bool, done bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.r < r.w {
+		word = r.buf[r.r&r.bufMask]
+		r.r++
+
+		return word, true, false
+	}
+
+	return nil, false, !r.running
+}
+
+func (r *workerRing) counts() (generated, scanned uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.generated, r.scanned
+}
+
+// run feeds the ring from r.shard until the shard is exhausted or pg is
+// stopped.
+func (r *workerRing) run(pg *WordGen, groups [][]byte) {
+	defer func() {
+		r.mu.Lock()
+		r.running = false
+		r.mu.Unlock()
+	}()
+
+	for {
+		pg.mu.Lock()
+		running := pg.running
+		pg.mu.Unlock()
+
+		if !running {
+			return
+		}
+
+		r.mu.Lock()
+
+		if r.shard.done {
+			r.mu.Unlock()
+			return
+		}
+
+		if r.w-r.r >= uint64(len(r.buf)) {
+			r.mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+
+			continue
+		}
+
+		next := r.shard.word(r.getScratch())
+		r.scanned++
+
+		if len(groups) > 0 && !satisfiesRequirements(next, groups) {
+			r.putScratch(next)
+
+			if err := r.shard.next(); err != nil {
+				r.mu.Unlock()
+				return
+			}
+
+			r.mu.Unlock()
+
+			continue
+		}
+
+		idx := r.w & r.bufMask
+		r.putScratch(r.buf[idx])
+		r.buf[idx] = next
+		r.w++
+		r.generated++
+
+		done := r.shard.next() != nil
+
+		r.mu.Unlock()
+
+		if done {
+			return
+		}
+	}
+}
+
+// setupWorkers partitions the keyspace into pg.config.Workers shards and
+// allocates one workerRing per shard. It assumes pg.mu is held.
+func (pg *WordGen) setupWorkers() {
+	workers := pg.config.Workers
+
+	shardDigits := shardDigitsFor(pg.charsetLen, uint(workers))
+	if shardDigits > int(pg.config.MaxLen) {
+		// MaxLen leaves no room for a combo prefix this wide; shrink it so
+		// newShard never walks short lengths past MaxLen looking for one.
+		shardDigits = int(pg.config.MaxLen)
+	}
+
+	comboSpace := uint64(1)
+	for i := 0; i < shardDigits; i++ {
+		comboSpace *= uint64(pg.charsetLen)
+	}
+
+	base := comboSpace / uint64(workers)
+	rem := comboSpace % uint64(workers)
+
+	perWorkerBuf := pg.config.BufferSize / uint64(workers)
+	if perWorkerBuf < minBufferSize {
+		perWorkerBuf = minBufferSize
+	}
+
+	pg.workers = make([]*workerRing, workers)
+	pg.workerCursor = 0
+
+	var lo uint64
+	for w := 0; w < workers; w++ {
+		size := base
+		if uint64(w) < rem {
+			size++
+		}
+		hi := lo + size
+
+		sh := newShard(pg.charset, pg.config.MinLen, pg.config.MaxLen, shardDigits, lo, hi, w == 0)
+		pg.workers[w] = newWorkerRing(sh, perWorkerBuf, pg.config.MaxLen)
+
+		lo = hi
+	}
+}
+
+// runWorkers starts one goroutine per worker shard and waits for all of them
+// to finish.
+func (pg *WordGen) runWorkers() error {
+	groups := pg.requirementGroups()
+
+	var wg sync.WaitGroup
+
+	for _, ring := range pg.workers {
+		wg.Add(1)
+
+		go func(ring *workerRing) {
+			defer wg.Done()
+			ring.run(pg, groups)
+		}(ring)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// nextFromWorkers drains the next available word from the worker rings in
+// round-robin order, starting from pg.workerCursor. It returns io.EOF once
+// every worker has stopped and its ring has been fully drained.
+//
+// Because each worker enumerates its own shard of the keyspace
+// independently, words are no longer emitted in ModeSequential's
+// single-threaded order across the whole run when Config.Workers > 1.
+func (pg *WordGen) nextFromWorkers() ([]byte, error) {
+	n := len(pg.workers)
+
+	for {
+		pg.mu.Lock()
+		start := pg.workerCursor
+		pg.mu.Unlock()
+
+		allDone := true
+
+		for i := 0; i < n; i++ {
+			idx := (start + uint64(i)) % uint64(n)
+
+			word, ok, done := pg.workers[idx].tryTake()
+			if ok {
+				pg.mu.Lock()
+				pg.workerCursor = idx + 1
+				pg.mu.Unlock()
+
+				return word, nil
+			}
+
+			if !done {
+				allDone = false
+			}
+		}
+
+		if allDone {
+			return nil, io.EOF
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// batchFromWorkers fills words by repeatedly draining nextFromWorkers.
+func (pg *WordGen) batchFromWorkers(words [][]byte) (int, error) {
+	count := 0
+
+	for i := 0; i < len(words); i++ {
+		word, err := pg.nextFromWorkers()
+		if err != nil {
+			return count, nil
+		}
+
+		if cap(words[i]) < len(word) {
+			words[i] = make([]byte, len(word))
+		} else {
+			words[i] = words[i][:len(word)]
+		}
+
+		copy(words[i], word)
+		count++
+	}
+
+	return count, nil
+}
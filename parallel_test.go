@@ -0,0 +1,148 @@
+package wordgen
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordGenWorkersMatchesSequential(t *testing.T) {
+	for _, workers := range []int{2, 3, 5} {
+		want := drainAll(t, Config{Charset: "abcd", MinLen: 1, MaxLen: 4, BufferSize: 64})
+		got := drainAll(t, Config{Charset: "abcd", MinLen: 1, MaxLen: 4, BufferSize: 64, Workers: workers})
+
+		sort.Strings(want)
+		sort.Strings(got)
+
+		require.Equal(t, want, got)
+	}
+}
+
+func TestWordGenWorkersRespectsMaxLen(t *testing.T) {
+	// A tiny charset with many workers drives shardDigitsFor above MaxLen;
+	// the short-length fallback must not grow past it looking for a combo
+	// prefix that doesn't fit.
+	words := drainAll(t, Config{Charset: "ab", MinLen: 3, MaxLen: 4, BufferSize: 64, Workers: 64})
+
+	require.NotEmpty(t, words)
+	for _, w := range words {
+		require.LessOrEqual(t, len(w), 4)
+	}
+}
+
+func TestWordGenWorkersRequire(t *testing.T) {
+	cfg := Config{
+		Charset:    "ab01",
+		MinLen:     3,
+		MaxLen:     3,
+		BufferSize: 64,
+		Workers:    4,
+		Require:    ClassDigit,
+	}
+
+	pg, err := NewWordGen(cfg)
+	require.NoError(t, err)
+
+	words := drainAll(t, cfg)
+	require.NotEmpty(t, words)
+
+	groups := pg.requirementGroups()
+	for _, w := range words {
+		require.True(t, satisfiesRequirements([]byte(w), groups), w)
+	}
+}
+
+func TestWordGenWorkersStats(t *testing.T) {
+	cfg := Config{Charset: "abc", MinLen: 1, MaxLen: 3, BufferSize: 64, Workers: 4}
+
+	pg, err := NewWordGen(cfg)
+	require.NoError(t, err)
+
+	errCh, err := pg.Run(context.Background())
+	require.NoError(t, err)
+
+	var count int
+	for {
+		if _, err := pg.Next(); err != nil {
+			require.True(t, errors.Is(err, io.EOF))
+			break
+		}
+		count++
+	}
+	require.NoError(t, <-errCh)
+
+	generated, _ := pg.Stats()
+	require.Equal(t, uint64(count), generated)
+}
+
+func TestWordGenWorkersIgnoredOutsideSequential(t *testing.T) {
+	pg, err := NewWordGen(Config{
+		Charset:    "abc",
+		MinLen:     3,
+		MaxLen:     3,
+		BufferSize: 64,
+		Workers:    4,
+		Mode:       ModeRandom,
+		Count:      5,
+	})
+	require.NoError(t, err)
+
+	errCh, err := pg.Run(context.Background())
+	require.NoError(t, err)
+
+	var count int
+	for {
+		_, err := pg.Next()
+		if err != nil {
+			require.True(t, errors.Is(err, io.EOF))
+			break
+		}
+		count++
+	}
+
+	require.Equal(t, 5, count)
+	require.NoError(t, <-errCh)
+}
+
+func benchmarkWordGen(b *testing.B, workers int) {
+	pg, err := NewWordGen(Config{
+		Charset:    defaultCharset,
+		MinLen:     4,
+		MaxLen:     4,
+		BufferSize: 1 << 16,
+		Workers:    workers,
+	})
+	require.NoError(b, err)
+
+	errCh, err := pg.Run(context.Background())
+	require.NoError(b, err)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := pg.Next(); err != nil {
+			break
+		}
+	}
+
+	b.StopTimer()
+
+	pg.Stop()
+	<-errCh
+}
+
+func BenchmarkWordGenSequential(b *testing.B) {
+	benchmarkWordGen(b, 0)
+}
+
+func BenchmarkWordGenWorkers4(b *testing.B) {
+	benchmarkWordGen(b, 4)
+}
+
+func BenchmarkWordGenWorkers8(b *testing.B) {
+	benchmarkWordGen(b, 8)
+}
@@ -0,0 +1,240 @@
+package wordgen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// CharClass identifies a category of characters that Config.Require can
+// mandate at least one instance of in every emitted word.
+type CharClass uint8
+
+const (
+	ClassLower CharClass = 1 << iota
+	ClassUpper
+	ClassDigit
+	ClassSymbol
+)
+
+const symbolChars = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+// classChars returns the character set associated with a single CharClass
+// bit.
+func classChars(c CharClass) string {
+	switch c {
+	case ClassLower:
+		return "abcdefghijklmnopqrstuvwxyz"
+	case ClassUpper:
+		return "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	case ClassDigit:
+		return "0123456789"
+	case ClassSymbol:
+		return symbolChars
+	default:
+		return ""
+	}
+}
+
+// className names a CharClass bit for error messages.
+func className(c CharClass) string {
+	switch c {
+	case ClassLower:
+		return "ClassLower"
+	case ClassUpper:
+		return "ClassUpper"
+	case ClassDigit:
+		return "ClassDigit"
+	case ClassSymbol:
+		return "ClassSymbol"
+	default:
+		return "unknown class"
+	}
+}
+
+// validateRequirements checks that every group Config.Require and
+// Config.RequireCustom demand can actually be drawn from the charset the
+// generator will produce words from - effectiveCharset in ModeSequential
+// and ModeRandom, pronounceableAlphabet in ModePronounceable - and that the
+// groups can all be satisfied by a single word within Config.MaxLen.
+// Without this, an unsatisfiable requirement would make every candidate
+// fail forever, spinning ModeRandom/ModePronounceable at 100% CPU with no
+// way to exhaust.
+func validateRequirements(config Config) error {
+	if config.Require == 0 && len(config.RequireCustom) == 0 {
+		return nil
+	}
+
+	var alphabet []byte
+	if config.Mode == ModePronounceable {
+		alphabet = pronounceableAlphabet(config)
+	} else {
+		alphabet = effectiveCharset(config)
+	}
+
+	var groups [][]byte
+
+	for _, class := range []CharClass{ClassLower, ClassUpper, ClassDigit, ClassSymbol} {
+		if config.Require&class == 0 {
+			continue
+		}
+
+		want := []byte(classChars(class))
+		if !containsAny(alphabet, want) {
+			return fmt.Errorf("wordgen: Config.Require includes %s but the effective charset has no such character", className(class))
+		}
+
+		groups = append(groups, intersectBytes(alphabet, want))
+	}
+
+	for _, custom := range config.RequireCustom {
+		want := []byte(custom)
+		if !containsAny(alphabet, want) {
+			return fmt.Errorf("wordgen: Config.RequireCustom %q has no character in the effective charset", custom)
+		}
+
+		groups = append(groups, intersectBytes(alphabet, want))
+	}
+
+	if need := minHittingSetSize(groups); need > int(config.MaxLen) {
+		return fmt.Errorf("wordgen: Config.Require/RequireCustom need at least %d distinct characters in a single word, but MaxLen is %d", need, config.MaxLen)
+	}
+
+	return nil
+}
+
+// intersectBytes returns the distinct bytes of a that also appear in b,
+// preserving a's order.
+func intersectBytes(a, b []byte) []byte {
+	seen := make(map[byte]bool, len(a))
+
+	var out []byte
+	for _, c := range a {
+		if seen[c] || bytes.IndexByte(b, c) < 0 {
+			continue
+		}
+
+		seen[c] = true
+		out = append(out, c)
+	}
+
+	return out
+}
+
+// minHittingSetSize returns the minimum number of characters a single word
+// needs so that every group in groups contains at least one of them - the
+// true feasibility bound for a combined Require/RequireCustom policy, as
+// opposed to checking each group against the charset in isolation. It
+// explores candidate hits via backtracking: at each step it picks the first
+// not-yet-covered group and branches over each of its characters as the
+// next hit, pruning branches that can no longer beat the best solution
+// found so far. This is exponential in len(groups) in the worst case, but
+// groups here come from at most 4 CharClass bits plus the caller's
+// RequireCustom list, which in practice is small.
+func minHittingSetSize(groups [][]byte) int {
+	return minHittingSetRec(groups, 0, len(groups))
+}
+
+func minHittingSetRec(groups [][]byte, hits, best int) int {
+	if len(groups) == 0 {
+		if hits < best {
+			best = hits
+		}
+
+		return best
+	}
+
+	if hits+1 >= best {
+		// even a single hit that covers everything else can't beat best
+		return best
+	}
+
+	target := groups[0]
+	for _, c := range target {
+		var remaining [][]byte
+		for _, g := range groups[1:] {
+			if bytes.IndexByte(g, c) < 0 {
+				remaining = append(remaining, g)
+			}
+		}
+
+		best = minHittingSetRec(remaining, hits+1, best)
+	}
+
+	return best
+}
+
+// requirementGroups returns the set of character groups a word must draw
+// from, one group per required class, combining Config.Require and
+// Config.RequireCustom. A word satisfies the policy when it contains at
+// least one character from every group.
+func (pg *WordGen) requirementGroups() [][]byte {
+	if pg.config.Require == 0 && len(pg.config.RequireCustom) == 0 {
+		return nil
+	}
+
+	var groups [][]byte
+
+	for _, class := range []CharClass{ClassLower, ClassUpper, ClassDigit, ClassSymbol} {
+		if pg.config.Require&class != 0 {
+			groups = append(groups, []byte(classChars(class)))
+		}
+	}
+
+	for _, custom := range pg.config.RequireCustom {
+		groups = append(groups, []byte(custom))
+	}
+
+	return groups
+}
+
+// satisfiesRequirements reports whether word contains at least one
+// character from every group in groups.
+func satisfiesRequirements(word []byte, groups [][]byte) bool {
+	for _, group := range groups {
+		if !containsAny(word, group) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsAny(word, set []byte) bool {
+	for _, c := range word {
+		if bytes.IndexByte(set, c) >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// effectiveCharset returns the charset a WordGen built from config would
+// actually use for generation, i.e. config.Charset with config.Exclude
+// applied.
+func effectiveCharset(config Config) []byte {
+	charset := []byte(config.Charset)
+	if config.Exclude != "" {
+		charset = excludeChars(charset, config.Exclude)
+	}
+
+	return charset
+}
+
+// excludeChars returns charset with every character found in exclude
+// removed, preserving the original order.
+func excludeChars(charset []byte, exclude string) []byte {
+	excluded := make(map[byte]bool, len(exclude))
+	for _, c := range []byte(exclude) {
+		excluded[c] = true
+	}
+
+	out := charset[:0]
+	for _, c := range charset {
+		if !excluded[c] {
+			out = append(out, c)
+		}
+	}
+
+	return out
+}
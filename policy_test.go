@@ -0,0 +1,136 @@
+package wordgen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordGenRequire(t *testing.T) {
+	pg, err := NewWordGen(Config{
+		Charset:    "abc123",
+		MinLen:     4,
+		MaxLen:     4,
+		BufferSize: 10,
+		Require:    ClassDigit,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh, err := pg.Run(ctx)
+	require.NoError(t, err)
+
+	for i := 0; i < 200; i++ {
+		word, err := pg.Next()
+		require.NoError(t, err)
+		require.True(t, containsAny(word, []byte("123")), "word %q should contain a digit", word)
+	}
+
+	pg.Stop()
+	require.NoError(t, <-errCh)
+
+	require.GreaterOrEqual(t, pg.Scanned(), uint64(200))
+}
+
+func TestWordGenRequireCustom(t *testing.T) {
+	pg, err := NewWordGen(Config{
+		Charset:       "ab!",
+		MinLen:        3,
+		MaxLen:        3,
+		BufferSize:    10,
+		RequireCustom: []string{"!"},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh, err := pg.Run(ctx)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		word, err := pg.Next()
+		require.NoError(t, err)
+		require.Contains(t, string(word), "!")
+	}
+
+	pg.Stop()
+	require.NoError(t, <-errCh)
+}
+
+func TestWordGenRequireUnsatisfiableRejected(t *testing.T) {
+	_, err := NewWordGen(Config{
+		Charset: "abc",
+		MinLen:  3,
+		MaxLen:  3,
+		Require: ClassDigit,
+	})
+	require.Error(t, err)
+
+	_, err = NewWordGen(Config{
+		Charset:       "abc",
+		MinLen:        3,
+		MaxLen:        3,
+		RequireCustom: []string{"!"},
+	})
+	require.Error(t, err)
+}
+
+func TestWordGenRequireUnsatisfiableByExcludeRejected(t *testing.T) {
+	_, err := NewWordGen(Config{
+		Charset: "abc123",
+		Exclude: "123",
+		MinLen:  3,
+		MaxLen:  3,
+		Require: ClassDigit,
+	})
+	require.Error(t, err)
+}
+
+func TestWordGenRequireUnsatisfiableInPronounceableRejected(t *testing.T) {
+	_, err := NewWordGen(Config{
+		MinLen:  4,
+		MaxLen:  6,
+		Mode:    ModePronounceable,
+		Require: ClassUpper,
+	})
+	require.Error(t, err)
+}
+
+func TestWordGenRequireTooManyGroupsForLengthRejected(t *testing.T) {
+	_, err := NewWordGen(Config{
+		Charset: "aA1!",
+		MinLen:  1,
+		MaxLen:  1,
+		Mode:    ModeRandom,
+		Require: ClassLower | ClassUpper | ClassDigit | ClassSymbol,
+	})
+	require.Error(t, err)
+
+	// MaxLen grown to fit one character per class succeeds.
+	_, err = NewWordGen(Config{
+		Charset: "aA1!",
+		MinLen:  4,
+		MaxLen:  4,
+		Mode:    ModeRandom,
+		Require: ClassLower | ClassUpper | ClassDigit | ClassSymbol,
+	})
+	require.NoError(t, err)
+}
+
+func TestExcludeChars(t *testing.T) {
+	pg, err := NewWordGen(Config{
+		Charset: "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789",
+		Exclude: "l1IO0",
+		MinLen:  1,
+		MaxLen:  1,
+	})
+	require.NoError(t, err)
+
+	for _, c := range "l1IO0" {
+		require.NotContains(t, string(pg.charset), string(c))
+	}
+}
@@ -0,0 +1,218 @@
+package wordgen
+
+import "fmt"
+
+// unitFlag tags a syllable unit with the properties that govern how it may
+// be combined with its neighbours, following the FIPS-181 approach to
+// pronounceable password generation.
+type unitFlag uint8
+
+const (
+	flagVowel unitFlag = 1 << iota
+	flagConsonant
+	flagDiphthong
+	flagNotFirst // unit may not open a word
+	flagNotLast  // unit may not close a word
+)
+
+// pronounceableUnit is a single syllable building block, such as a vowel,
+// a consonant, or a digraph like "sh".
+type pronounceableUnit struct {
+	text  string
+	flags unitFlag
+}
+
+// pronounceableUnits is the full table of syllable units ModePronounceable
+// assembles words from: single vowels and consonants, plus vowel and
+// consonant digraphs.
+var pronounceableUnits = []pronounceableUnit{
+	// single vowels
+	{"a", flagVowel}, {"e", flagVowel}, {"i", flagVowel},
+	{"o", flagVowel}, {"u", flagVowel}, {"y", flagVowel},
+
+	// single consonants
+	{"b", flagConsonant}, {"c", flagConsonant}, {"d", flagConsonant},
+	{"f", flagConsonant}, {"g", flagConsonant}, {"h", flagConsonant},
+	{"j", flagConsonant}, {"k", flagConsonant}, {"l", flagConsonant},
+	{"m", flagConsonant}, {"n", flagConsonant}, {"p", flagConsonant},
+	{"q", flagConsonant}, {"r", flagConsonant}, {"s", flagConsonant},
+	{"t", flagConsonant}, {"v", flagConsonant}, {"w", flagConsonant},
+	{"x", flagConsonant}, {"z", flagConsonant},
+
+	// vowel digraphs
+	{"ai", flagVowel | flagDiphthong},
+	{"ay", flagVowel | flagDiphthong | flagNotFirst},
+	{"ea", flagVowel | flagDiphthong},
+	{"ee", flagVowel | flagDiphthong},
+	{"ie", flagVowel | flagDiphthong},
+	{"oo", flagVowel | flagDiphthong | flagNotFirst},
+	{"ou", flagVowel | flagDiphthong},
+
+	// consonant digraphs
+	{"ch", flagConsonant | flagDiphthong},
+	{"gh", flagConsonant | flagDiphthong | flagNotFirst},
+	{"ph", flagConsonant | flagDiphthong},
+	{"rh", flagConsonant | flagDiphthong | flagNotFirst},
+	{"sh", flagConsonant | flagDiphthong},
+	{"th", flagConsonant | flagDiphthong},
+	{"wh", flagConsonant | flagDiphthong},
+}
+
+// pronounceableAlphabet returns every character ModePronounceable can
+// possibly emit: the letters used by pronounceableUnits, plus "-" when
+// Config.HyphenateSyllables inserts syllable separators. It ignores
+// Config.Charset entirely, since this mode never draws from it.
+func pronounceableAlphabet(config Config) []byte {
+	seen := make(map[byte]bool)
+
+	var alphabet []byte
+	for _, unit := range pronounceableUnits {
+		for i := 0; i < len(unit.text); i++ {
+			c := unit.text[i]
+			if !seen[c] {
+				seen[c] = true
+				alphabet = append(alphabet, c)
+			}
+		}
+	}
+
+	if config.HyphenateSyllables {
+		alphabet = append(alphabet, '-')
+	}
+
+	return alphabet
+}
+
+const maxPronounceableAttempts = 64
+
+// fillPronounceableWord assembles a pronounceable word whose units total a
+// length within [MinLen, MaxLen], appending it to dst and returning the
+// extended slice. The "-" separators added by Config.HyphenateSyllables are
+// not counted in that length, so a hyphenated word is longer by one byte per
+// separator.
+func (pg *WordGen) fillPronounceableWord(dst []byte) ([]byte, error) {
+	for attempt := 0; attempt < maxPronounceableAttempts; attempt++ {
+		units, ok, err := pg.tryPronounceableWord()
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		dst = dst[:0]
+		for i, u := range units {
+			if i > 0 && pg.config.HyphenateSyllables {
+				dst = append(dst, '-')
+			}
+			dst = append(dst, u.text...)
+		}
+
+		return dst, nil
+	}
+
+	return nil, fmt.Errorf("wordgen: could not assemble a pronounceable word within [%d, %d] after %d attempts", pg.config.MinLen, pg.config.MaxLen, maxPronounceableAttempts)
+}
+
+// tryPronounceableWord assembles a single candidate word by repeatedly
+// picking a random eligible unit. It reports ok=false if it paints itself
+// into a corner (no eligible unit fits the remaining length budget) so the
+// caller can simply start over.
+func (pg *WordGen) tryPronounceableWord() ([]pronounceableUnit, bool, error) {
+	var units []pronounceableUnit
+	var length uint
+
+	for {
+		candidates := eligiblePronounceableUnits(units, pg.config.MaxLen-length)
+		if len(candidates) == 0 {
+			return nil, false, nil
+		}
+
+		n, err := randUintN(uint(len(candidates)))
+		if err != nil {
+			return nil, false, err
+		}
+
+		unit := candidates[n]
+		units = append(units, unit)
+		length += uint(len(unit.text))
+
+		if length < pg.config.MinLen || unit.flags&flagNotLast != 0 {
+			continue
+		}
+
+		if length == pg.config.MaxLen {
+			return units, true, nil
+		}
+
+		// in range and allowed to stop here; flip a coin so word length
+		// varies instead of always hugging MaxLen
+		stop, err := randUintN(2)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if stop == 0 {
+			return units, true, nil
+		}
+	}
+}
+
+// eligiblePronounceableUnits returns the units that may legally follow prev,
+// given remaining bytes of length budget: the first unit may not be
+// flagNotFirst, vowel-class and consonant-class units must alternate, at
+// most one diphthong may appear back-to-back, and a vowel-class unit is
+// mandatory within every three consecutive units.
+func eligiblePronounceableUnits(prev []pronounceableUnit, remaining uint) []pronounceableUnit {
+	var needVowel, needConsonant, noDiphthong, forceVowel bool
+
+	if len(prev) > 0 {
+		last := prev[len(prev)-1]
+		if last.flags&flagVowel != 0 {
+			needConsonant = true
+		} else {
+			needVowel = true
+		}
+
+		noDiphthong = last.flags&flagDiphthong != 0
+	}
+
+	if len(prev) >= 2 {
+		a, b := prev[len(prev)-2], prev[len(prev)-1]
+		if a.flags&flagVowel == 0 && b.flags&flagVowel == 0 {
+			forceVowel = true
+		}
+	}
+
+	var out []pronounceableUnit
+	for _, u := range pronounceableUnits {
+		if uint(len(u.text)) > remaining {
+			continue
+		}
+
+		if len(prev) == 0 && u.flags&flagNotFirst != 0 {
+			continue
+		}
+
+		if needVowel && u.flags&flagVowel == 0 {
+			continue
+		}
+
+		if needConsonant && u.flags&flagConsonant == 0 {
+			continue
+		}
+
+		if noDiphthong && u.flags&flagDiphthong != 0 {
+			continue
+		}
+
+		if forceVowel && u.flags&flagVowel == 0 {
+			continue
+		}
+
+		out = append(out, u)
+	}
+
+	return out
+}
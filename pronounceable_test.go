@@ -0,0 +1,95 @@
+package wordgen
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordGenPronounceableMode(t *testing.T) {
+	t.Run("respects length bounds and Count", func(t *testing.T) {
+		pg, err := NewWordGen(Config{
+			MinLen:     4,
+			MaxLen:     8,
+			BufferSize: 10,
+			Mode:       ModePronounceable,
+			Count:      200,
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		errCh, err := pg.Run(ctx)
+		require.NoError(t, err)
+
+		var words []string
+		for {
+			word, err := pg.Next()
+			if err != nil {
+				break
+			}
+			words = append(words, string(word))
+		}
+
+		require.Len(t, words, 200)
+
+		for _, word := range words {
+			require.GreaterOrEqual(t, len(word), 4)
+			require.LessOrEqual(t, len(word), 8)
+		}
+
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("hyphenates syllables", func(t *testing.T) {
+		pg, err := NewWordGen(Config{
+			MinLen:             6,
+			MaxLen:             6,
+			BufferSize:         4,
+			Mode:               ModePronounceable,
+			Count:              20,
+			HyphenateSyllables: true,
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		errCh, err := pg.Run(ctx)
+		require.NoError(t, err)
+
+		for {
+			word, err := pg.Next()
+			if err != nil {
+				break
+			}
+			require.True(t, strings.Contains(string(word), "-"))
+		}
+
+		require.NoError(t, <-errCh)
+	})
+}
+
+func TestEligiblePronounceableUnits(t *testing.T) {
+	for _, u := range eligiblePronounceableUnits(nil, 10) {
+		require.Equal(t, unitFlag(0), u.flags&flagNotFirst)
+	}
+
+	vowel := pronounceableUnit{"a", flagVowel}
+	for _, u := range eligiblePronounceableUnits([]pronounceableUnit{vowel}, 10) {
+		require.NotEqual(t, unitFlag(0), u.flags&flagConsonant)
+	}
+
+	consonant := pronounceableUnit{"th", flagConsonant | flagDiphthong}
+	for _, u := range eligiblePronounceableUnits([]pronounceableUnit{consonant}, 10) {
+		require.Equal(t, unitFlag(0), u.flags&flagDiphthong)
+	}
+
+	// no budget left for any two-letter unit
+	for _, u := range eligiblePronounceableUnits(nil, 1) {
+		require.Len(t, u.text, 1)
+	}
+}
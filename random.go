@@ -0,0 +1,159 @@
+package wordgen
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+)
+
+// Mode selects the strategy WordGen uses to produce words.
+type Mode int
+
+const (
+	// ModeSequential walks the keyspace length by length, with the first
+	// character varying fastest (a, b, c, aa, ba, ca, ab, ...). This is the
+	// default mode.
+	ModeSequential Mode = iota
+
+	// ModeRandom draws each word uniformly at random from the configured
+	// charset and length range, using crypto/rand as the entropy source.
+	// Unlike ModeSequential, it never exhausts the keyspace on its own; set
+	// Config.Count to stop after a fixed number of words.
+	ModeRandom
+
+	// ModePronounceable assembles human-pronounceable words out of
+	// alternating vowel/consonant syllable units instead of picking
+	// characters from Config.Charset. Like ModeRandom, it never exhausts
+	// on its own; set Config.Count to stop after a fixed number of words.
+	ModePronounceable
+)
+
+// fillRandomWord picks a random length in [MinLen, MaxLen] and a random
+// character at each position, appending the result to dst and returning the
+// extended slice.
+func (pg *WordGen) fillRandomWord(dst []byte) ([]byte, error) {
+	length, err := pg.randomLength()
+	if err != nil {
+		return nil, err
+	}
+
+	dst = dst[:0]
+
+	for i := uint(0); i < length; i++ {
+		n, err := randUintN(pg.charsetLen)
+		if err != nil {
+			return nil, err
+		}
+
+		dst = append(dst, pg.charset[n])
+	}
+
+	return dst, nil
+}
+
+// randomLength picks a word length in [MinLen, MaxLen]. By default every
+// length is equally likely; with Config.WeightLengthByKeyspace it is instead
+// weighted by the size of each length's keyspace (charsetLen^length), so
+// that the overall distribution across all lengths matches the true
+// keyspace rather than over-representing short words.
+func (pg *WordGen) randomLength() (uint, error) {
+	if pg.config.MinLen == pg.config.MaxLen {
+		return pg.config.MinLen, nil
+	}
+
+	span := pg.config.MaxLen - pg.config.MinLen + 1
+
+	if !pg.config.WeightLengthByKeyspace {
+		n, err := randUintN(span)
+		if err != nil {
+			return 0, err
+		}
+
+		return pg.config.MinLen + n, nil
+	}
+
+	// weights are kept in log-space and exponentiated back so that large
+	// charsets/lengths don't overflow a plain charsetLen^length product
+	logCharsetLen := math.Log(float64(pg.charsetLen))
+
+	weights := make([]float64, span)
+	total := 0.0
+	for i := uint(0); i < span; i++ {
+		weights[i] = math.Exp(float64(pg.config.MinLen+i) * logCharsetLen)
+		total += weights[i]
+	}
+
+	target, err := randFloat64()
+	if err != nil {
+		return 0, err
+	}
+	target *= total
+
+	acc := 0.0
+	for i := uint(0); i < span; i++ {
+		acc += weights[i]
+		if target < acc {
+			return pg.config.MinLen + i, nil
+		}
+	}
+
+	// guards against floating point rounding leaving a tiny remainder
+	return pg.config.MaxLen, nil
+}
+
+// randUintN returns a cryptographically random value in [0, n) using
+// rejection sampling, so that every value in the range is equally likely.
+// A plain "random % n" would be biased whenever n does not evenly divide
+// the range of the underlying random bytes.
+func randUintN(n uint) (uint, error) {
+	if n == 0 {
+		return 0, fmt.Errorf("n must be greater than zero")
+	}
+
+	if n == 1 {
+		return 0, nil
+	}
+
+	bitLen := bits.Len(n - 1)
+	byteLen := (bitLen + 7) / 8
+
+	mask := byte(1<<uint(bitLen%8) - 1)
+	if bitLen%8 == 0 {
+		mask = 0xff
+	}
+
+	buf := make([]byte, byteLen)
+
+	for {
+		if _, err := io.ReadFull(rand.Reader, buf); err != nil {
+			return 0, err
+		}
+
+		buf[0] &= mask
+
+		v := uint(0)
+		for _, b := range buf {
+			v = v<<8 | uint(b)
+		}
+
+		if v < n {
+			return v, nil
+		}
+	}
+}
+
+// randFloat64 returns a cryptographically random float64 in [0, 1).
+func randFloat64() (float64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return 0, err
+	}
+
+	// 53 significant bits is the full mantissa precision of a float64
+	v := binary.BigEndian.Uint64(b[:]) >> 11
+
+	return float64(v) / float64(uint64(1)<<53), nil
+}
@@ -0,0 +1,92 @@
+package wordgen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordGenRandomMode(t *testing.T) {
+	t.Run("respects length bounds and Count", func(t *testing.T) {
+		pg, err := NewWordGen(Config{
+			Charset:    "abc",
+			MinLen:     3,
+			MaxLen:     6,
+			BufferSize: 10,
+			Mode:       ModeRandom,
+			Count:      50,
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		errCh, err := pg.Run(ctx)
+		require.NoError(t, err)
+
+		var words []string
+		for {
+			word, err := pg.Next()
+			if err != nil {
+				break
+			}
+			words = append(words, string(word))
+		}
+
+		require.Len(t, words, 50)
+
+		for _, word := range words {
+			require.GreaterOrEqual(t, len(word), 3)
+			require.LessOrEqual(t, len(word), 6)
+			for _, c := range word {
+				require.Contains(t, "abc", string(c))
+			}
+		}
+
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("unlimited by default", func(t *testing.T) {
+		pg, err := NewWordGen(Config{
+			Charset:    "ab",
+			MinLen:     2,
+			MaxLen:     2,
+			BufferSize: 4,
+			Mode:       ModeRandom,
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		errCh, err := pg.Run(ctx)
+		require.NoError(t, err)
+
+		for i := 0; i < 1000; i++ {
+			_, err := pg.Next()
+			require.NoError(t, err)
+		}
+
+		pg.Stop()
+		require.NoError(t, <-errCh)
+	})
+}
+
+func TestRandUintN(t *testing.T) {
+	seen := map[uint]bool{}
+	for i := 0; i < 500; i++ {
+		n, err := randUintN(7)
+		require.NoError(t, err)
+		require.Less(t, n, uint(7))
+		seen[n] = true
+	}
+	require.Len(t, seen, 7)
+
+	_, err := randUintN(0)
+	require.Error(t, err)
+
+	n, err := randUintN(1)
+	require.NoError(t, err)
+	require.Equal(t, uint(0), n)
+}
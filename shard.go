@@ -0,0 +1,190 @@
+package wordgen
+
+import "io"
+
+// shard enumerates the slice of the sequential keyspace assigned to one
+// worker when Config.Workers > 1. For lengths at least shardDigits long, it
+// treats the first shardDigits state positions as a single base-charsetLen
+// "combo" number and only enumerates combo values in [loCombo, hiCombo) for
+// this worker, walking every possible suffix for each one. Within a length
+// the suffix varies fastest and the combo prefix slowest, so a shard's order
+// differs from nextState's, where state[0] varies fastest.
+//
+// Lengths shorter than shardDigits have no room for a combo prefix at all.
+// Since they're a vanishingly small fraction of any keyspace large enough
+// to be worth sharding, they are enumerated in full by the first worker
+// (isFirst) before combo/suffix sharding begins; other workers skip them
+// entirely.
+type shard struct {
+	charset    []byte
+	charsetLen uint
+	maxLen     uint
+
+	shardDigits int
+	loCombo     uint64
+	hiCombo     uint64
+
+	short       []uint
+	shortActive bool
+
+	length int
+	combo  uint64
+	suffix []uint
+
+	done bool
+}
+
+func newShard(charset []byte, minLen, maxLen uint, shardDigits int, loCombo, hiCombo uint64, isFirst bool) *shard {
+	s := &shard{
+		charset:     charset,
+		charsetLen:  uint(len(charset)),
+		maxLen:      maxLen,
+		shardDigits: shardDigits,
+		loCombo:     loCombo,
+		hiCombo:     hiCombo,
+	}
+
+	if isFirst && int(minLen) < shardDigits {
+		s.short = make([]uint, minLen)
+		s.shortActive = true
+		return s
+	}
+
+	length := int(minLen)
+	if length < shardDigits {
+		length = shardDigits
+	}
+
+	s.startCombo(length)
+
+	return s
+}
+
+// startCombo begins combo/suffix enumeration at length, which must be >=
+// shardDigits.
+func (s *shard) startCombo(length int) {
+	if uint(length) > s.maxLen || s.loCombo >= s.hiCombo {
+		s.done = true
+		return
+	}
+
+	s.length = length
+	s.combo = s.loCombo
+	s.suffix = make([]uint, length-s.shardDigits)
+}
+
+// word renders the shard's current position, appending it to dst and
+// returning the extended slice.
+func (s *shard) word(dst []byte) []byte {
+	dst = dst[:0]
+
+	if s.shortActive {
+		for _, d := range s.short {
+			dst = append(dst, s.charset[d])
+		}
+
+		return dst
+	}
+
+	combo := s.combo
+	for i := 0; i < s.shardDigits; i++ {
+		dst = append(dst, s.charset[uint(combo%uint64(s.charsetLen))])
+		combo /= uint64(s.charsetLen)
+	}
+
+	for _, d := range s.suffix {
+		dst = append(dst, s.charset[d])
+	}
+
+	return dst
+}
+
+// next advances the shard to its next position. It returns io.EOF once the
+// shard has walked every word assigned to it.
+func (s *shard) next() error {
+	if s.done {
+		return io.EOF
+	}
+
+	if s.shortActive {
+		if err := s.nextShort(); err != nil {
+			// all lengths shorter than shardDigits are done; move into
+			// the combo/suffix phase starting at length == shardDigits
+			s.shortActive = false
+			s.startCombo(s.shardDigits)
+
+			if s.done {
+				return io.EOF
+			}
+		}
+
+		return nil
+	}
+
+	for i := 0; ; i++ {
+		if i >= len(s.suffix) {
+			s.combo++
+			if s.combo < s.hiCombo {
+				s.suffix = make([]uint, len(s.suffix))
+				return nil
+			}
+
+			s.startCombo(s.length + 1)
+			if s.done {
+				return io.EOF
+			}
+
+			return nil
+		}
+
+		s.suffix[i]++
+		if s.suffix[i] < s.charsetLen {
+			return nil
+		}
+
+		s.suffix[i] = 0
+	}
+}
+
+// nextShort advances the full-width short-length state exactly like
+// nextState does for the single-threaded generator, returning io.EOF once
+// length would reach shardDigits.
+func (s *shard) nextShort() error {
+	for i := 0; ; i++ {
+		if i >= len(s.short) {
+			if len(s.short)+1 >= s.shardDigits {
+				return io.EOF
+			}
+
+			s.short = append(s.short, 0)
+
+			return nil
+		}
+
+		s.short[i]++
+		if s.short[i] < s.charsetLen {
+			return nil
+		}
+
+		s.short[i] = 0
+	}
+}
+
+// shardDigitsFor returns the smallest K such that charsetLen^K >= workers,
+// the number of leading state positions sharding needs to fan out across
+// that many workers.
+func shardDigitsFor(charsetLen, workers uint) int {
+	if charsetLen < 2 || workers < 2 {
+		return 1
+	}
+
+	k := 1
+	space := charsetLen
+
+	for space < workers {
+		k++
+		space *= charsetLen
+	}
+
+	return k
+}
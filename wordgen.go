@@ -28,6 +28,54 @@ type Config struct {
 	MinLen       uint
 	MaxLen       uint
 	BufferSize   uint64
+
+	// Mode selects how words are produced. It defaults to ModeSequential,
+	// which walks the keyspace length by length, first character fastest.
+	Mode Mode
+
+	// Count limits the number of words generated in ModeRandom, after which
+	// the generator reports io.EOF. It is ignored in ModeSequential, where
+	// the keyspace itself determines when generation ends. Zero means
+	// unlimited.
+	Count uint64
+
+	// WeightLengthByKeyspace makes ModeRandom pick a length in
+	// [MinLen, MaxLen] with probability proportional to the size of that
+	// length's keyspace, instead of uniformly. This better reflects the
+	// true distribution of the overall keyspace when MinLen and MaxLen are
+	// far apart.
+	WeightLengthByKeyspace bool
+
+	// HyphenateSyllables inserts a "-" between syllable units in
+	// ModePronounceable. It is ignored in all other modes.
+	HyphenateSyllables bool
+
+	// Require enumerates character classes that must each contribute at
+	// least one character to every emitted word.
+	Require CharClass
+
+	// RequireCustom lists additional custom character sets that must each
+	// contribute at least one character to every emitted word, beyond
+	// Require.
+	RequireCustom []string
+
+	// Exclude lists characters to remove from Charset before generation,
+	// e.g. visually ambiguous characters like "l1IO0".
+	Exclude string
+
+	// Workers splits ModeSequential enumeration across N goroutines, each
+	// walking a disjoint shard of the keyspace with its own ring buffer,
+	// instead of a single goroutine serialized behind one mutex. Values
+	// less than 2 disable sharding. It is ignored in ModeRandom and
+	// ModePronounceable, which have no shared enumeration state to
+	// contend over in the first place.
+	//
+	// With Workers > 1, words are no longer emitted in ModeSequential's
+	// single-threaded order across the whole run: each worker still emits
+	// its own shard in order, but Next/Batch round-robin across workers
+	// as they produce. Checkpoint and BatchBorrow are not supported in
+	// this mode.
+	Workers int
 }
 
 type WordGen struct {
@@ -40,6 +88,7 @@ type WordGen struct {
 	endTime   time.Time
 
 	generated uint64
+	scanned   uint64
 
 	bufSize uint64
 	bufMask uint64
@@ -56,10 +105,21 @@ type WordGen struct {
 	stateLen     int
 
 	running bool
+
+	scratchPool sync.Pool
+	borrowed    bool
+
+	// workers is non-nil once Run has started a ModeSequential run with
+	// Config.Workers > 1, in which case Next, Batch and Stats all read from
+	// the worker rings instead of buf.
+	workers      []*workerRing
+	workerCursor uint64
 }
 
-// NewWordGen creates a new WordGen instance with the provided configuration.
-func NewWordGen(config Config) (*WordGen, error) {
+// normalizeConfig fills in config defaults and rejects values that can
+// never produce a valid generator. It is shared by NewWordGen and the
+// checkpoint fingerprint check, so that both apply defaults identically.
+func normalizeConfig(config Config) (Config, error) {
 	if config.MinLen == 0 {
 		config.MinLen = defaultMinLen
 	}
@@ -73,13 +133,23 @@ func NewWordGen(config Config) (*WordGen, error) {
 	}
 
 	if config.MinLen > config.MaxLen {
-		return nil, fmt.Errorf("min length cannot be greater than max length")
+		return config, fmt.Errorf("min length cannot be greater than max length")
 	}
 
 	if config.BufferSize < minBufferSize {
 		config.BufferSize = minBufferSize
 	}
 
+	return config, nil
+}
+
+// NewWordGen creates a new WordGen instance with the provided configuration.
+func NewWordGen(config Config) (*WordGen, error) {
+	config, err := normalizeConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	pg := &WordGen{
 		config:       config,
 		charset:      []byte(config.Charset),
@@ -88,6 +158,11 @@ func NewWordGen(config Config) (*WordGen, error) {
 		initialState: make([]uint, config.MaxLen),
 	}
 
+	if config.Exclude != "" {
+		pg.charset = excludeChars(pg.charset, config.Exclude)
+		pg.charsetLen = uint(len(pg.charset))
+	}
+
 	// validate charset
 	if pg.charsetLen == 0 {
 		return nil, fmt.Errorf("charset cannot be empty")
@@ -102,6 +177,10 @@ func NewWordGen(config Config) (*WordGen, error) {
 		charsetSeen[c] = true
 	}
 
+	if err := validateRequirements(config); err != nil {
+		return nil, err
+	}
+
 	if len(config.InitialState) > 0 {
 		if len(config.InitialState) < int(config.MinLen) {
 			return nil, fmt.Errorf("initial state is less than min length")
@@ -138,9 +217,31 @@ func NewWordGen(config Config) (*WordGen, error) {
 
 	pg.genCond = sync.NewCond(&pg.mu)
 
+	maxLen := config.MaxLen
+	pg.scratchPool.New = func() interface{} {
+		return make([]byte, 0, maxLen)
+	}
+
 	return pg, nil
 }
 
+// getScratch returns an empty byte slice from the scratch pool, sized to
+// hold any word this generator can produce without reallocating.
+func (pg *WordGen) getScratch() []byte {
+	return pg.scratchPool.Get().([]byte)[:0]
+}
+
+// putScratch returns a byte slice to the scratch pool for reuse. It is a
+// no-op for nil slices, which a freshly allocated ring buffer slot may still
+// hold the first time it's swapped out.
+func (pg *WordGen) putScratch(b []byte) {
+	if b == nil {
+		return
+	}
+
+	pg.scratchPool.Put(b[:0])
+}
+
 // Run starts the password generator. It will generate passwords in the
 // background and store them in a buffer. The buffer size is determined by the
 // BufferSize parameter in the configuration.
@@ -157,14 +258,22 @@ func (pg *WordGen) Run(ctx context.Context) (<-chan error, error) {
 	pg.startTime = time.Now()
 	pg.endTime = time.Time{}
 
-	pg.state = make([]uint, len(pg.initialState), pg.config.MaxLen)
+	useWorkers := pg.config.Workers > 1 && pg.config.Mode == ModeSequential
 
-	// copy initial state
-	copy(pg.state, pg.initialState)
+	if useWorkers {
+		pg.setupWorkers()
+	} else {
+		pg.workers = nil
+
+		pg.state = make([]uint, len(pg.initialState), pg.config.MaxLen)
 
-	pg.stateLen = len(pg.state)
-	pg.bufR = 0
-	pg.bufW = 0
+		// copy initial state
+		copy(pg.state, pg.initialState)
+
+		pg.stateLen = len(pg.state)
+		pg.bufR = 0
+		pg.bufW = 0
+	}
 
 	pg.running = true
 
@@ -174,15 +283,26 @@ func (pg *WordGen) Run(ctx context.Context) (<-chan error, error) {
 		<-ctx.Done()
 		pg.mu.Lock()
 		pg.running = false
+		pg.genCond.Broadcast()
 		pg.mu.Unlock()
 	}()
 
 	go func() {
-		err := pg.runGenerator()
+		var err error
+		if useWorkers {
+			err = pg.runWorkers()
+		} else {
+			err = pg.runGenerator()
+		}
 
 		pg.mu.Lock()
 		pg.endTime = time.Now()
 		pg.running = false
+		// wake any consumer blocked in Next/Batch/BatchBorrow: the
+		// generator may have stopped without ever writing a word, e.g. no
+		// remaining candidate satisfying Require, or Count already reached, and
+		// such a consumer would otherwise wait forever.
+		pg.genCond.Broadcast()
 		pg.mu.Unlock()
 
 		errCh <- err
@@ -200,8 +320,17 @@ func (pg *WordGen) Stats() (uint64, time.Duration) {
 	generated := pg.generated
 	startTime := pg.startTime
 	endTime := pg.endTime
+	workers := pg.workers
 	pg.mu.Unlock()
 
+	if workers != nil {
+		generated = 0
+		for _, w := range workers {
+			g, _ := w.counts()
+			generated += g
+		}
+	}
+
 	if startTime.IsZero() {
 		return 0, 0
 	}
@@ -213,13 +342,48 @@ func (pg *WordGen) Stats() (uint64, time.Duration) {
 	return generated, endTime.Sub(startTime)
 }
 
+// Scanned returns the number of candidate words examined so far, including
+// ones filtered out for not satisfying Config.Require/Config.RequireCustom.
+// Comparing it against the generated count from Stats shows how much the
+// class constraints are narrowing the keyspace.
+func (pg *WordGen) Scanned() uint64 {
+	pg.mu.Lock()
+	scanned := pg.scanned
+	workers := pg.workers
+	pg.mu.Unlock()
+
+	if workers != nil {
+		scanned = 0
+		for _, w := range workers {
+			_, s := w.counts()
+			scanned += s
+		}
+	}
+
+	return scanned
+}
+
 // Next generates the next password and returns it as a byte slice.
 func (pg *WordGen) Next() ([]byte, error) {
+	pg.mu.Lock()
+	workers := pg.workers
+	pg.mu.Unlock()
+
+	if workers != nil {
+		return pg.nextFromWorkers()
+	}
+
 	var idx uint64
 	var buf []byte
 
 	pg.mu.Lock()
 
+	if pg.borrowed {
+		pg.mu.Unlock()
+
+		return nil, fmt.Errorf("wordgen: a BatchBorrow batch is outstanding, call its release before Next")
+	}
+
 	if pg.bufR >= pg.bufW {
 		// buffer is empty, check if generator is still running
 		if !pg.running {
@@ -231,6 +395,13 @@ func (pg *WordGen) Next() ([]byte, error) {
 		// wait for the generator to fill the buffer
 		pg.genCond.Wait()
 
+		// a BatchBorrow call may have run while we were waiting
+		if pg.borrowed {
+			pg.mu.Unlock()
+
+			return nil, fmt.Errorf("wordgen: a BatchBorrow batch is outstanding, call its release before Next")
+		}
+
 		// after waking up, check again if we have data
 		if pg.bufR >= pg.bufW {
 			pg.mu.Unlock()
@@ -258,6 +429,19 @@ func (pg *WordGen) Batch(words [][]byte) (int, error) {
 	}
 
 	pg.mu.Lock()
+	workers := pg.workers
+	pg.mu.Unlock()
+
+	if workers != nil {
+		return pg.batchFromWorkers(words)
+	}
+
+	pg.mu.Lock()
+
+	if pg.borrowed {
+		pg.mu.Unlock()
+		return 0, fmt.Errorf("wordgen: a BatchBorrow batch is outstanding, call its release before Batch")
+	}
 
 	count := 0
 	for i := 0; i < len(words); i++ {
@@ -273,6 +457,12 @@ func (pg *WordGen) Batch(words [][]byte) (int, error) {
 			// wait for the generator to fill the buffer
 			pg.genCond.Wait()
 
+			// a BatchBorrow call may have run while we were waiting
+			if pg.borrowed {
+				pg.mu.Unlock()
+				return count, fmt.Errorf("wordgen: a BatchBorrow batch is outstanding, call its release before Batch")
+			}
+
 			// after waking up, check again if we have data
 			if pg.bufR >= pg.bufW {
 				// still no data, generator must have stopped
@@ -304,17 +494,100 @@ func (pg *WordGen) Batch(words [][]byte) (int, error) {
 	return count, nil
 }
 
+// ReleaseFunc returns a batch of words borrowed via BatchBorrow to the
+// generator. It must be called exactly once per BatchBorrow call, even when
+// the returned batch is empty; it is safe to call more than once.
+type ReleaseFunc func()
+
+func noopRelease() {}
+
+// BatchBorrow returns up to n words as direct references into the
+// generator's internal ring buffer, instead of copying them the way Batch
+// does. The returned words - and the ring slots backing them - remain
+// untouched by the producer until release is called, so callers can process
+// them without a per-word allocation or copy. BatchBorrow must not be
+// called again until the previous call's release has run; doing so returns
+// an error.
+func (pg *WordGen) BatchBorrow(n int) (words [][]byte, release ReleaseFunc, err error) {
+	if n <= 0 {
+		return nil, noopRelease, nil
+	}
+
+	pg.mu.Lock()
+
+	if pg.workers != nil {
+		pg.mu.Unlock()
+		return nil, noopRelease, fmt.Errorf("wordgen: BatchBorrow is not supported when Config.Workers > 1, use Batch instead")
+	}
+
+	if pg.borrowed {
+		pg.mu.Unlock()
+		return nil, noopRelease, fmt.Errorf("wordgen: previous BatchBorrow batch has not been released yet")
+	}
+
+	if pg.bufR >= pg.bufW {
+		if !pg.running {
+			pg.mu.Unlock()
+			return nil, noopRelease, io.EOF
+		}
+
+		pg.genCond.Wait()
+
+		// another BatchBorrow call may have run while we were waiting
+		if pg.borrowed {
+			pg.mu.Unlock()
+			return nil, noopRelease, fmt.Errorf("wordgen: previous BatchBorrow batch has not been released yet")
+		}
+
+		if pg.bufR >= pg.bufW {
+			pg.mu.Unlock()
+			return nil, noopRelease, io.EOF
+		}
+	}
+
+	if avail := pg.bufW - pg.bufR; uint64(n) > avail {
+		n = int(avail)
+	}
+
+	words = make([][]byte, n)
+	for i := 0; i < n; i++ {
+		idx := (pg.bufR + uint64(i)) & pg.bufMask
+		words[i] = pg.buf[idx]
+	}
+
+	pg.borrowed = true
+
+	start := pg.bufR
+	var once sync.Once
+	release = func() {
+		once.Do(func() {
+			pg.mu.Lock()
+			pg.bufR = start + uint64(n)
+			pg.borrowed = false
+			pg.mu.Unlock()
+		})
+	}
+
+	pg.mu.Unlock()
+
+	return words, release, nil
+}
+
 // Stop stops the password generator.
 func (pg *WordGen) Stop() {
 	// stop the generator
 	pg.mu.Lock()
 	pg.running = false
+	// wake any consumer blocked in Next/Batch/BatchBorrow so Stop always
+	// unblocks them, even if the generator never writes another word
+	pg.genCond.Broadcast()
 	pg.mu.Unlock()
 }
 
 func (pg *WordGen) runGenerator() error {
 	var idx uint64
-	var buf *[]byte
+
+	groups := pg.requirementGroups()
 
 	for {
 		pg.mu.Lock()
@@ -324,6 +597,13 @@ func (pg *WordGen) runGenerator() error {
 			return nil
 		}
 
+		// ModeRandom and ModePronounceable don't exhaust a keyspace, so
+		// Count is the only way to bring the generator to a natural end
+		if pg.config.Mode != ModeSequential && pg.config.Count > 0 && pg.generated >= pg.config.Count {
+			pg.mu.Unlock()
+			return nil
+		}
+
 		if pg.bufW-pg.bufR >= pg.bufSize {
 			pg.mu.Unlock()
 
@@ -334,37 +614,93 @@ func (pg *WordGen) runGenerator() error {
 
 		idx = pg.bufW & pg.bufMask
 
-		buf = &pg.buf[idx]
+		var next []byte
+		var err error
 
-		// resize buffer to state length
-		*buf = (*buf)[:pg.stateLen]
+		switch pg.config.Mode {
+		case ModeRandom:
+			next, err = pg.fillRandomWord(pg.getScratch())
+			if err != nil {
+				pg.mu.Unlock()
+				return fmt.Errorf("fillRandomWord: %w", err)
+			}
+		case ModePronounceable:
+			next, err = pg.fillPronounceableWord(pg.getScratch())
+			if err != nil {
+				pg.mu.Unlock()
+				return fmt.Errorf("fillPronounceableWord: %w", err)
+			}
+		default:
+			next = pg.getScratch()
+			for i := 0; i < pg.stateLen; i++ {
+				next = append(next, pg.charset[pg.state[i]])
+			}
+		}
+
+		pg.scanned++
+
+		if len(groups) > 0 && !satisfiesRequirements(next, groups) {
+			// candidate doesn't satisfy Require/RequireCustom: advance
+			// past it without publishing it to the ring buffer
+			pg.putScratch(next)
+
+			done, err := pg.advanceSequentialState()
+			pg.mu.Unlock()
+
+			if err != nil {
+				return err
+			}
+			if done {
+				return nil
+			}
 
-		// fill buffer with charset characters
-		for i := 0; i < pg.stateLen; i++ {
-			(*buf)[i] = pg.charset[pg.state[i]]
+			continue
 		}
 
+		// swap a fresh slice into the ring slot so that any word a
+		// consumer is still holding a reference to (e.g. via
+		// BatchBorrow) is never mutated from under it
+		pg.putScratch(pg.buf[idx])
+		pg.buf[idx] = next
+
 		// update write index
 		pg.bufW = pg.bufW + 1
 		pg.generated++
 
 		pg.genCond.Signal()
 
-		// update next state
-		if err := pg.nextState(); err != nil {
-			pg.mu.Unlock()
-			if errors.Is(err, io.EOF) {
-				// EOF means we have generated all passwords
-				// and we can stop the generator
-				return nil
-			}
+		done, err := pg.advanceSequentialState()
+		pg.mu.Unlock()
 
-			return fmt.Errorf("generateNext: %w", err)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
 		}
-		pg.mu.Unlock()
 	}
+}
 
-	return nil
+// advanceSequentialState moves to the next state when running in
+// ModeSequential; other modes draw a fresh candidate on every iteration and
+// have no state to advance. It reports done=true once the keyspace has been
+// fully enumerated, signalling the generator should stop.
+func (pg *WordGen) advanceSequentialState() (done bool, err error) {
+	if pg.config.Mode != ModeSequential {
+		return false, nil
+	}
+
+	if err := pg.nextState(); err != nil {
+		if errors.Is(err, io.EOF) {
+			// EOF means we have generated all passwords and we can stop
+			// the generator
+			return true, nil
+		}
+
+		return false, fmt.Errorf("generateNext: %w", err)
+	}
+
+	return false, nil
 }
 
 func (pg *WordGen) nextState() error {
@@ -202,6 +202,38 @@ func TestWordGenNext(t *testing.T) {
 	})
 }
 
+// TestWordGenStopUnblocksConsumerWithNoWordsProduced exercises a consumer
+// already parked in Next()'s genCond.Wait() when the generator stops
+// without ever writing a word - e.g. no remaining candidate satisfying
+// Require, or Count already reached. Stop must still wake it instead of leaving
+// it blocked forever.
+func TestWordGenStopUnblocksConsumerWithNoWordsProduced(t *testing.T) {
+	wordgen, err := NewWordGen(Config{Charset: "ab", MinLen: 2, MaxLen: 2, BufferSize: 4})
+	require.NoError(t, err)
+
+	// simulate a generator that's running but has not produced anything
+	// yet, without actually starting runGenerator
+	wordgen.mu.Lock()
+	wordgen.running = true
+	wordgen.mu.Unlock()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := wordgen.Next()
+		done <- err
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	wordgen.Stop()
+
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, io.EOF)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Next() did not unblock after Stop()")
+	}
+}
+
 func TestWordGenBatch(t *testing.T) {
 	wordgen, err := NewWordGen(Config{
 		MinLen:     3,